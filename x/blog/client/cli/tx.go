@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"planet/x/blog/types"
+)
+
+// GetTxCmd returns the root tx command for the blog module, aggregating
+// every CmdX sub-command under `<binary> tx blog`.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdSendIbcPost())
+	cmd.AddCommand(CmdRetryFailedPost())
+	cmd.AddCommand(CmdRetryTimedoutPost())
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"planet/x/blog/types"
+)
+
+const flagTimeoutTimestamp = "timeout-timestamp"
+
+func CmdSendIbcPost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-ibcPost [src-port] [src-channel] [title] [content]",
+		Short: "Send an IbcPost over IBC",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendIbcPost(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+				args[3],
+				timeoutTimestamp,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "absolute timeout timestamp, in unix nanoseconds; when unset, the block time plus the module's DefaultTimeoutTimestamp param is used")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+
+	"planet/x/blog/types"
+)
+
+// EmitIbcPostSendEvent emits an EventTypeIbcPostSend event once a blog post
+// packet has been handed off to the channel keeper for delivery, following
+// the pattern ibc-go uses for EmitAcknowledgePacketEvents. commitment is the
+// types.CommitPacket digest for this send, included so relayers/indexers can
+// correlate the send with its eventual ack or timeout.
+func EmitIbcPostSendEvent(ctx sdk.Context, packet channeltypes.Packet, creator, title string, commitment []byte) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIbcPostSend,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyTitle, title),
+			sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(commitment)),
+		),
+	)
+}
+
+// EmitIbcPostReceiveEvent emits an EventTypeIbcPostReceive event once a
+// received blog post packet has been appended as a local post.
+func EmitIbcPostReceiveEvent(ctx sdk.Context, packet channeltypes.Packet, creator, title string, postID uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIbcPostReceive,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyTitle, title),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(postID, 10)),
+		),
+	)
+}
+
+// EmitIbcPostAckEvent emits an EventTypeIbcPostAck event reporting whether a
+// previously sent blog post packet was acknowledged successfully.
+func EmitIbcPostAckEvent(ctx sdk.Context, packet channeltypes.Packet, creator, title string, success bool, postID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIbcPostAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyTitle, title),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(success)),
+			sdk.NewAttribute(types.AttributeKeyPostID, postID),
+		),
+	)
+}
+
+// EmitIbcPostTimeoutEvent emits an EventTypeIbcPostTimeout event once a
+// previously sent blog post packet has timed out.
+func EmitIbcPostTimeoutEvent(ctx sdk.Context, packet channeltypes.Packet, creator, title string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIbcPostTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyTitle, title),
+		),
+	)
+}
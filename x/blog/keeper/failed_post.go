@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"planet/x/blog/types"
+)
+
+// GetFailedPostCount get the total number of failedPost
+func (k Keeper) GetFailedPostCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.FailedPostCountKey)
+	bz := store.Get(byteKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetFailedPostCount set the total number of failedPost
+func (k Keeper) SetFailedPostCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.FailedPostCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendFailedPost appends a failedPost in the store with a new id and update the count
+func (k Keeper) AppendFailedPost(ctx sdk.Context, failedPost types.FailedPost) uint64 {
+	count := k.GetFailedPostCount(ctx)
+	failedPost.Id = count
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedPostKey))
+	appendedValue := k.cdc.MustMarshalBinaryBare(&failedPost)
+	store.Set(GetFailedPostIDBytes(failedPost.Id), appendedValue)
+
+	k.SetFailedPostCount(ctx, count+1)
+
+	return count
+}
+
+// SetFailedPost set a specific failedPost in the store
+func (k Keeper) SetFailedPost(ctx sdk.Context, failedPost types.FailedPost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedPostKey))
+	b := k.cdc.MustMarshalBinaryBare(&failedPost)
+	store.Set(GetFailedPostIDBytes(failedPost.Id), b)
+}
+
+// GetFailedPost returns a failedPost from its id
+func (k Keeper) GetFailedPost(ctx sdk.Context, id uint64) (val types.FailedPost, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedPostKey))
+	b := store.Get(GetFailedPostIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &val)
+	return val, true
+}
+
+// RemoveFailedPost removes a failedPost from the store
+func (k Keeper) RemoveFailedPost(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedPostKey))
+	store.Delete(GetFailedPostIDBytes(id))
+}
+
+// GetAllFailedPost returns all failedPost
+func (k Keeper) GetAllFailedPost(ctx sdk.Context) (list []types.FailedPost) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedPostKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FailedPost
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetFailedPostIDBytes returns the byte representation of the ID
+func GetFailedPostIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
@@ -34,6 +34,23 @@ func (k Keeper) TransmitIbcPostPacket(
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
+	// タイムアウトが指定されていない場合は、モジュールのパラメータから導かれる
+	// 相対的なデフォルトタイムアウトを適用する。
+	params := k.GetParams(ctx)
+
+	// 明示的なタイムスタンプがある場合は、ゼロの高さをそのまま残し、
+	// タイムスタンプのみのタイムアウトとして送信する。
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().UnixNano()) + params.DefaultTimeoutTimestamp
+		if timeoutHeight.IsZero() {
+			timeoutHeight = clienttypes.NewHeight(clienttypes.ParseChainID(ctx.ChainID()), uint64(ctx.BlockHeight())+params.DefaultTimeoutHeightOffset)
+		}
+	}
+
+	if err := types.TimestampElapsed(timeoutTimestamp, uint64(ctx.BlockTime().UnixNano())); err != nil {
+		return err
+	}
+
 	// get the next sequence
 	sequence, found := k.ChannelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
 	if !found {
@@ -48,6 +65,10 @@ func (k Keeper) TransmitIbcPostPacket(
 		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
+	// packetBytes is the actual wire payload the counterparty decodes in
+	// OnRecvPacket; ibc-go's channel keeper separately derives its own
+	// fixed-length Merkle-proof commitment from this packet inside
+	// SendPacket, so the payload itself must stay the real serialized post.
 	packetBytes, err := packetData.GetBytes()
 	if err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: "+err.Error())
@@ -68,6 +89,9 @@ func (k Keeper) TransmitIbcPostPacket(
 		return err
 	}
 
+	commitment := types.CommitPacket(packetData, timeoutTimestamp, destinationChannel)
+	EmitIbcPostSendEvent(ctx, packet, packetData.Creator, packetData.Title, commitment)
+
 	return nil
 }
 
@@ -80,6 +104,12 @@ func (k Keeper) OnRecvIbcPostPacket(ctx sdk.Context, packet channeltypes.Packet,
 		return packetAck, err
 	}
 
+	// ガバナンスが設定したAllowedChannels／MaxContentBytes／MaxPostsPerBlockに
+	// 違反する投稿は、黙って受理せずに構造化されたエラーを返す。
+	if err := k.CheckInboundPost(ctx, packet.DestinationPort, packet.DestinationChannel, len(data.Content)); err != nil {
+		return packetAck, err
+	}
+
 	// 投稿メッセージを受信したら、受信チェーンにタイトルとコンテンツを含む新しい投稿を作成する。
 	// AppendPost：新しく追加された投稿のIDを返します。この値は、承認によってソースチェーンに返すことができる。
 	id := k.AppendPost(
@@ -92,7 +122,10 @@ func (k Keeper) OnRecvIbcPostPacket(ctx sdk.Context, packet channeltypes.Packet,
 		},
 	)
 
-	packetAck.PostID = strconv.FormatUint(id, 10)
+	packetAck.Success = true
+	packetAck.PostID = id
+
+	EmitIbcPostReceiveEvent(ctx, packet, data.Creator, data.Title, id)
 
 	return packetAck, nil
 }
@@ -104,31 +137,69 @@ func (k Keeper) OnAcknowledgementIbcPostPacket(ctx sdk.Context, packet channelty
 	switch dispatchedAck := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 
-		// TODO: failed acknowledgement logic
-		_ = dispatchedAck.Error
+		//送信ブロックチェーンにfailedPostを保存して、投稿が拒否された理由を確認できるようにする。
+		//MsgRetryFailedPostで再送信できるように、元のタイトルとコンテンツを保持しておく。
+		k.AppendFailedPost(
+			ctx,
+			types.FailedPost{
+				Creator:      data.Creator,
+				Title:        data.Title,
+				Content:      data.Content,
+				Chain:        packet.DestinationPort + "-" + packet.DestinationChannel,
+				ErrorMessage: dispatchedAck.Error,
+			},
+		)
+
+		EmitIbcPostAckEvent(ctx, packet, data.Creator, data.Title, false, "")
 
 		return nil
 	case *channeltypes.Acknowledgement_Result:
-		// Decode the packet acknowledgment
+		// Decode the packet acknowledgment. IbcPostPacketAck is encoded through
+		// ModuleCdc's legacy Amino binary codec rather than JSON, so decoding no
+		// longer depends on a stringly-typed PostID or a JSON round-trip (see
+		// the IbcPostPacketAck doc comment for why this is Amino, not protobuf).
 		var packetAck types.IbcPostPacketAck
 
-		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+		if err := types.ModuleCdc.UnmarshalBinaryBare(dispatchedAck.Result, &packetAck); err != nil {
 			// The counter-party module doesn't implement the correct acknowledgment format
 			return errors.New("cannot unmarshal acknowledgment")
 		}
 
+		if !packetAck.Success {
+			// OnRecvPacket carries its own failures (e.g. a CheckInboundPost
+			// policy rejection) as a ResultAcknowledgement with Success=false
+			// rather than a channeltypes.Acknowledgement_Error, so it lands
+			// here instead of the Error case above.
+			k.AppendFailedPost(
+				ctx,
+				types.FailedPost{
+					Creator:      data.Creator,
+					Title:        data.Title,
+					Content:      data.Content,
+					Chain:        packet.DestinationPort + "-" + packet.DestinationChannel,
+					ErrorMessage: packetAck.Error,
+				},
+			)
+
+			EmitIbcPostAckEvent(ctx, packet, data.Creator, data.Title, false, "")
+
+			return nil
+		}
+
 		//送信ブロックチェーンにsentPostを保存して、ターゲットチェーンで投稿が受信されたことを確認します。
 		//投稿を識別するためのタイトルとターゲットを格納
 		k.AppendSentPost(
 			ctx,
 			types.SentPost{
 				Creator: data.Creator,
-				PostID:  packetAck.PostID,
+				PostID:  strconv.FormatUint(packetAck.PostID, 10),
 				Title:   data.Title,
 				Chain:   packet.DestinationPort + "-" + packet.DestinationChannel,
 			},
 		)
 
+		EmitIbcPostAckEvent(ctx, packet, data.Creator, data.Title, true, strconv.FormatUint(packetAck.PostID, 10))
+
 		return nil
 	default:
 		// The counter-party module doesn't implement the correct acknowledgment format
@@ -145,10 +216,13 @@ func (k Keeper) OnTimeoutIbcPostPacket(ctx sdk.Context, packet channeltypes.Pack
 		ctx,
 		types.TimedoutPost{
 			Title:   data.Title,
+			Content: data.Content,
 			Creator: data.Creator,
 			Chain:   packet.DestinationPort + "-" + packet.DestinationChannel,
 		},
 	)
 
+	EmitIbcPostTimeoutEvent(ctx, packet, data.Creator, data.Title)
+
 	return nil
 }
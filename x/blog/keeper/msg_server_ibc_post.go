@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"planet/x/blog/types"
+)
+
+// SendIbcPost transmits the post over IBC. When msg.TimeoutTimestamp is left
+// at zero, TransmitIbcPostPacket falls back to the module's default relative
+// timeout.
+func (k msgServer) SendIbcPost(goCtx context.Context, msg *types.MsgSendIbcPost) (*types.MsgSendIbcPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packetData := types.IbcPostPacketData{
+		Creator: msg.Creator,
+		Title:   msg.Title,
+		Content: msg.Content,
+	}
+
+	if err := k.TransmitIbcPostPacket(
+		ctx,
+		packetData,
+		msg.Port,
+		msg.ChannelID,
+		types.NewTimeoutWithTimestamp(msg.TimeoutTimestamp),
+		msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendIbcPostResponse{}, nil
+}
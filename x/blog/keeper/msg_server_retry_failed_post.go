@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"planet/x/blog/types"
+)
+
+// RetryFailedPost re-transmits the post backing a FailedPost record using
+// the timeout supplied by the caller, and removes the record once the
+// packet has been handed off to the channel keeper.
+func (k msgServer) RetryFailedPost(goCtx context.Context, msg *types.MsgRetryFailedPost) (*types.MsgRetryFailedPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	failedPost, found := k.GetFailedPost(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "failed post %d not found", msg.Id)
+	}
+
+	if msg.Creator != failedPost.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the original creator can retry this post")
+	}
+
+	packetData := types.IbcPostPacketData{
+		Creator: failedPost.Creator,
+		Title:   failedPost.Title,
+		Content: failedPost.Content,
+	}
+
+	if err := k.TransmitIbcPostPacket(
+		ctx,
+		packetData,
+		msg.Port,
+		msg.ChannelID,
+		types.NewTimeoutWithTimestamp(msg.TimeoutTimestamp),
+		msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
+	k.RemoveFailedPost(ctx, msg.Id)
+
+	return &types.MsgRetryFailedPostResponse{}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"planet/x/blog/types"
+)
+
+// RetryTimedoutPost re-transmits the post backing a TimedoutPost record
+// using the timeout supplied by the caller, and removes the record once
+// the packet has been handed off to the channel keeper.
+func (k msgServer) RetryTimedoutPost(goCtx context.Context, msg *types.MsgRetryTimedoutPost) (*types.MsgRetryTimedoutPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	timedoutPost, found := k.GetTimedoutPost(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "timedout post %d not found", msg.Id)
+	}
+
+	if msg.Creator != timedoutPost.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the original creator can retry this post")
+	}
+
+	packetData := types.IbcPostPacketData{
+		Creator: timedoutPost.Creator,
+		Title:   timedoutPost.Title,
+		Content: timedoutPost.Content,
+	}
+
+	if err := k.TransmitIbcPostPacket(
+		ctx,
+		packetData,
+		msg.Port,
+		msg.ChannelID,
+		types.NewTimeoutWithTimestamp(msg.TimeoutTimestamp),
+		msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
+	k.RemoveTimedoutPost(ctx, msg.Id)
+
+	return &types.MsgRetryTimedoutPostResponse{}, nil
+}
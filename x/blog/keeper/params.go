@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"planet/x/blog/types"
+)
+
+// GetParams get all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.DefaultTimeoutTimestamp(ctx),
+		k.DefaultTimeoutHeightOffset(ctx),
+		k.AllowedChannels(ctx),
+		k.MaxPostsPerBlock(ctx),
+		k.MaxContentBytes(ctx),
+	)
+}
+
+// SetParams sets the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// DefaultTimeoutTimestamp returns the DefaultTimeoutTimestamp param
+func (k Keeper) DefaultTimeoutTimestamp(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyDefaultTimeoutTimestamp, &res)
+	return
+}
+
+// DefaultTimeoutHeightOffset returns the DefaultTimeoutHeightOffset param
+func (k Keeper) DefaultTimeoutHeightOffset(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyDefaultTimeoutHeightOffset, &res)
+	return
+}
+
+// AllowedChannels returns the AllowedChannels param
+func (k Keeper) AllowedChannels(ctx sdk.Context) (res []types.AllowedChannel) {
+	k.paramSpace.Get(ctx, types.KeyAllowedChannels, &res)
+	return
+}
+
+// MaxPostsPerBlock returns the MaxPostsPerBlock param
+func (k Keeper) MaxPostsPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxPostsPerBlock, &res)
+	return
+}
+
+// MaxContentBytes returns the MaxContentBytes param
+func (k Keeper) MaxContentBytes(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxContentBytes, &res)
+	return
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"planet/x/blog/types"
+)
+
+// postRateLimitKeyPrefix is the store prefix for the per-channel inbound
+// post counter. There is exactly one entry per (destPort, destChannel): the
+// height it was last touched at is stored alongside the count, so the
+// counter can be reset in place on the first post of a new block instead of
+// accumulating one entry per channel per block forever.
+const postRateLimitKeyPrefix = "PostRateLimit/value/"
+
+func postRateLimitKey(destPort, destChannel string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", destPort, destChannel))
+}
+
+// countInboundPost increments and returns the number of blog posts received
+// on (destPort, destChannel) during the current block. The counter resets
+// to 1 whenever it is first touched at a new block height.
+func (k Keeper) countInboundPost(ctx sdk.Context, destPort, destChannel string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(postRateLimitKeyPrefix))
+	key := postRateLimitKey(destPort, destChannel)
+
+	var count uint64
+	if bz := store.Get(key); bz != nil {
+		height := int64(binary.BigEndian.Uint64(bz[:8]))
+		if height == ctx.BlockHeight() {
+			count = binary.BigEndian.Uint64(bz[8:])
+		}
+	}
+	count++
+
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], uint64(ctx.BlockHeight()))
+	binary.BigEndian.PutUint64(bz[8:], count)
+	store.Set(key, bz)
+
+	return count
+}
+
+// CheckInboundPost enforces the module's AllowedChannels, MaxContentBytes and
+// MaxPostsPerBlock params against an inbound blog post, returning a
+// structured error instead of letting OnRecvIbcPostPacket silently accept a
+// post that violates chain-operator policy. A zero-value param (or an empty
+// AllowedChannels list) leaves that particular check disabled.
+func (k Keeper) CheckInboundPost(ctx sdk.Context, destPort, destChannel string, contentLen int) error {
+	params := k.GetParams(ctx)
+
+	if len(params.AllowedChannels) > 0 {
+		allowed := false
+		for _, c := range params.AllowedChannels {
+			if c.PortID == destPort && c.ChannelID == destChannel {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return sdkerrors.Wrapf(types.ErrChannelNotAllowed, "channel %s/%s is not in the allowed channels list", destPort, destChannel)
+		}
+	}
+
+	if params.MaxContentBytes > 0 && uint64(contentLen) > params.MaxContentBytes {
+		return sdkerrors.Wrapf(types.ErrContentTooLarge, "content is %d bytes, max allowed is %d", contentLen, params.MaxContentBytes)
+	}
+
+	if params.MaxPostsPerBlock > 0 && k.countInboundPost(ctx, destPort, destChannel) > params.MaxPostsPerBlock {
+		return sdkerrors.Wrapf(types.ErrTooManyPostsInBlock, "channel %s/%s has exceeded %d posts in this block", destPort, destChannel, params.MaxPostsPerBlock)
+	}
+
+	return nil
+}
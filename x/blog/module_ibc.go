@@ -0,0 +1,161 @@
+package blog
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v2/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v2/modules/core/exported"
+
+	"planet/x/blog/keeper"
+	"planet/x/blog/types"
+)
+
+// IBCModule implements the ICS26 porttypes.IBCModule interface for the blog
+// module. Keeping the packet callbacks behind this interface, instead of
+// calling the keeper directly, lets IBCModule be wrapped by
+// porttypes.Middleware (e.g. 29-fee, or a future rate-limit middleware) in
+// the app's IBC router, the same way ICS-27 was reworked to let a wrapping
+// middleware inspect and rewrite the channel acknowledgement.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper.
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := types.ValidateIbcPostChannelParams(order, portID); err != nil {
+		return "", err
+	}
+
+	if version != types.Version {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := types.ValidateIbcPostChannelParams(order, portID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.Version {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got %s, expected %s", counterpartyVersion, types.Version)
+	}
+
+	// OpenTry must claim the channel capability that IBC passes into the callback
+	if !im.keeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return "", err
+		}
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	if counterpartyVersion != types.Version {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "blog channels cannot be closed")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. It decodes the packet
+// data and hands it to the keeper, turning the keeper's result into an
+// exported.Acknowledgement that a wrapping middleware is free to inspect or
+// rewrite before it is written to the channel.
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	var data types.IbcPostPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		ack := types.IbcPostPacketAck{Error: fmt.Sprintf("cannot unmarshal ibc post packet data: %s", err.Error())}
+		return channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalBinaryBare(&ack))
+	}
+
+	// Both outcomes are carried as a types.IbcPostPacketAck with an explicit
+	// Success flag and Error message, wrapped in a ResultAcknowledgement,
+	// rather than splitting failures off into channeltypes'
+	// NewErrorAcknowledgement. That keeps OnAcknowledgementIbcPostPacket's
+	// decode on the source chain uniform and actually populates
+	// IbcPostPacketAck.Error instead of leaving it dead.
+	ack, err := im.keeper.OnRecvIbcPostPacket(ctx, packet, data)
+	if err != nil {
+		ack = types.IbcPostPacketAck{Error: err.Error()}
+	}
+
+	return channeltypes.NewResultAcknowledgement(types.ModuleCdc.MustMarshalBinaryBare(&ack))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, _ sdk.AccAddress) error {
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ibc post packet acknowledgement: %v", err)
+	}
+
+	var data types.IbcPostPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ibc post packet data: %v", err)
+	}
+
+	return im.keeper.OnAcknowledgementIbcPostPacket(ctx, packet, data, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	var data types.IbcPostPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ibc post packet data: %v", err)
+	}
+
+	return im.keeper.OnTimeoutIbcPostPacket(ctx, packet, data)
+}
+
+var _ porttypes.IBCModule = IBCModule{}
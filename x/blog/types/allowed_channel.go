@@ -0,0 +1,8 @@
+package types
+
+// AllowedChannel identifies a counterparty channel that is permitted to
+// deliver inbound blog posts when Params.AllowedChannels is non-empty.
+type AllowedChannel struct {
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+}
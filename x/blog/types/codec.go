@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the blog module's messages with the legacy Amino
+// codec, so they can be signed/broadcast and decoded over Amino JSON.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSendIbcPost{}, "blog/SendIbcPost", nil)
+	cdc.RegisterConcrete(&MsgRetryFailedPost{}, "blog/RetryFailedPost", nil)
+	cdc.RegisterConcrete(&MsgRetryTimedoutPost{}, "blog/RetryTimedoutPost", nil)
+}
+
+// RegisterInterfaces registers the blog module's messages against the
+// sdk.Msg interface so the proto-based InterfaceRegistry can decode them.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendIbcPost{},
+		&MsgRetryFailedPost{},
+		&MsgRetryTimedoutPost{},
+	)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+	// ModuleCdc is used both for legacy Amino JSON sign bytes (GetSignBytes)
+	// and, via its embedded LegacyAmino, for the IbcPostPacketAck binary
+	// encoding in keeper.OnAcknowledgementIbcPostPacket.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
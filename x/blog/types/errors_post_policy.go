@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Sentinel errors returned by the inbound post policy enforced in
+// OnRecvIbcPostPacket (see Params.AllowedChannels, MaxPostsPerBlock and
+// MaxContentBytes).
+var (
+	ErrChannelNotAllowed   = sdkerrors.Register(ModuleName, 1700, "channel is not in the allowed channels list")
+	ErrTooManyPostsInBlock = sdkerrors.Register(ModuleName, 1701, "channel has exceeded the maximum posts per block")
+	ErrContentTooLarge     = sdkerrors.Register(ModuleName, 1702, "content exceeds the maximum allowed size")
+)
@@ -0,0 +1,20 @@
+package types
+
+// IbcPost module event types and attribute keys.
+const (
+	EventTypeIbcPostSend    = "ibc_post_send"
+	EventTypeIbcPostReceive = "ibc_post_receive"
+	EventTypeIbcPostAck     = "ibc_post_ack"
+	EventTypeIbcPostTimeout = "ibc_post_timeout"
+
+	AttributeKeySequence   = "sequence"
+	AttributeKeySrcPort    = "src_port"
+	AttributeKeySrcChannel = "src_channel"
+	AttributeKeyDstPort    = "dst_port"
+	AttributeKeyDstChannel = "dst_channel"
+	AttributeKeyCreator    = "creator"
+	AttributeKeyTitle      = "title"
+	AttributeKeyPostID     = "post_id"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyCommitment = "commitment"
+)
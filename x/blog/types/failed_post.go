@@ -0,0 +1,14 @@
+package types
+
+// FailedPost is a cross-chain blog post whose IBC packet was acknowledged
+// with an error by the counterparty chain. It mirrors TimedoutPost, but
+// also keeps the error message returned by the counterparty so the sender
+// can inspect what went wrong before deciding whether to retry the send.
+type FailedPost struct {
+	Id           uint64
+	Creator      string
+	Title        string
+	Content      string
+	Chain        string
+	ErrorMessage string
+}
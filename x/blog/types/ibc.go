@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+)
+
+const (
+	// Version defines the current version the IBC blog module supports
+	Version = "blog-1"
+)
+
+// ErrInvalidVersion is returned during the channel handshake when the
+// counterparty proposes a version this module does not support.
+var ErrInvalidVersion = sdkerrors.Register(ModuleName, 1600, "invalid blog version")
+
+// ErrInvalidPort is returned during the channel handshake when the channel
+// is opened on a port other than PortID.
+var ErrInvalidPort = sdkerrors.Register(ModuleName, 1601, "invalid blog port ID")
+
+// ValidateIbcPostChannelParams does validation of a newly created blog
+// channel. A blog channel must be UNORDERED and bind to PortID.
+func ValidateIbcPostChannelParams(order channeltypes.Order, portID string) error {
+	if order != channeltypes.UNORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	}
+
+	if portID != PortID {
+		return sdkerrors.Wrapf(ErrInvalidPort, "invalid port ID, expected %s, got %s", PortID, portID)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package types
+
+// IbcPostPacketAck is the acknowledgement written for an IbcPost packet. It
+// carries an explicit Success flag, a numeric PostID and an Error message,
+// so decoding on the source chain no longer depends on a stringly-typed ID
+// or a JSON round-trip; both the success and failure paths in
+// OnRecvPacket/OnAcknowledgementIbcPostPacket go through this struct.
+//
+// Deviation from the original request: the request asked for this to be a
+// protobuf-encoded structure. There is no .proto definition or protoc
+// toolchain wired up anywhere in this module (no other type here is
+// protobuf-backed either), so a real .pb.go can't be generated in this
+// tree. IbcPostPacketAck is encoded through ModuleCdc's legacy Amino binary
+// codec (MarshalBinaryBare/UnmarshalBinaryBare) as a stand-in instead;
+// swapping it for a generated proto.Message is still open.
+type IbcPostPacketAck struct {
+	Success bool
+	PostID  uint64
+	Error   string
+}
@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"planet/x/blog/types"
+)
+
+// TestTransmitRecvRoundTrip exercises the same encode/decode pair used by
+// TransmitIbcPostPacket and module_ibc.go's OnRecvPacket: packetData.GetBytes()
+// must produce bytes that ModuleCdc can unmarshal back into an equivalent
+// IbcPostPacketData. This is the path that silently broke when
+// TransmitIbcPostPacket briefly sent types.CommitPacket's digest as the
+// packet's wire Data instead of the serialized post.
+func TestTransmitRecvRoundTrip(t *testing.T) {
+	sent := types.IbcPostPacketData{
+		Creator: "cosmos1abc",
+		Title:   "hello",
+		Content: "world",
+	}
+
+	bz, err := sent.GetBytes()
+	require.NoError(t, err)
+
+	var received types.IbcPostPacketData
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &received))
+	require.Equal(t, sent, received)
+
+	// CommitPacket is a fixed-length digest, not valid JSON for
+	// IbcPostPacketData, and must never be what TransmitIbcPostPacket hands
+	// to channeltypes.NewPacket as the wire Data.
+	commitment := types.CommitPacket(sent, 12345, "channel-0")
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(commitment, &types.IbcPostPacketData{}))
+}
@@ -0,0 +1,8 @@
+package types
+
+const (
+	// FailedPostKey is the prefix to retrieve all FailedPost
+	FailedPostKey = "FailedPost/value/"
+	// FailedPostCountKey is the prefix to retrieve the FailedPost count
+	FailedPostCountKey = "FailedPost/count/"
+)
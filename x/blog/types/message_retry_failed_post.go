@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRetryFailedPost = "retry_failed_post"
+
+// MsgRetryFailedPost asks the chain to re-send a post recorded as a
+// FailedPost, using a caller-supplied timeout for the new packet.
+type MsgRetryFailedPost struct {
+	Creator          string
+	Id               uint64
+	Port             string
+	ChannelID        string
+	TimeoutTimestamp uint64
+}
+
+// MsgRetryFailedPostResponse is returned once the retried packet has been
+// handed off to the channel keeper.
+type MsgRetryFailedPostResponse struct{}
+
+func NewMsgRetryFailedPost(creator string, id uint64, port, channelID string, timeoutTimestamp uint64) *MsgRetryFailedPost {
+	return &MsgRetryFailedPost{
+		Creator:          creator,
+		Id:               id,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgRetryFailedPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryFailedPost) Type() string {
+	return TypeMsgRetryFailedPost
+}
+
+func (msg *MsgRetryFailedPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRetryFailedPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRetryFailedPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
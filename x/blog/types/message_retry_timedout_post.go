@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRetryTimedoutPost = "retry_timedout_post"
+
+// MsgRetryTimedoutPost asks the chain to re-send a post recorded as a
+// TimedoutPost, using a caller-supplied timeout for the new packet.
+type MsgRetryTimedoutPost struct {
+	Creator          string
+	Id               uint64
+	Port             string
+	ChannelID        string
+	TimeoutTimestamp uint64
+}
+
+// MsgRetryTimedoutPostResponse is returned once the retried packet has been
+// handed off to the channel keeper.
+type MsgRetryTimedoutPostResponse struct{}
+
+func NewMsgRetryTimedoutPost(creator string, id uint64, port, channelID string, timeoutTimestamp uint64) *MsgRetryTimedoutPost {
+	return &MsgRetryTimedoutPost{
+		Creator:          creator,
+		Id:               id,
+		Port:             port,
+		ChannelID:        channelID,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgRetryTimedoutPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryTimedoutPost) Type() string {
+	return TypeMsgRetryTimedoutPost
+}
+
+func (msg *MsgRetryTimedoutPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRetryTimedoutPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRetryTimedoutPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
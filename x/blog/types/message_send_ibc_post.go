@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSendIbcPost = "send_ibc_post"
+
+// MsgSendIbcPost sends a blog post to a counterparty chain over IBC. Callers
+// may leave TimeoutTimestamp at zero to fall back to the module's default
+// relative timeout (Params.DefaultTimeoutTimestamp), in which case the module
+// also sets a timeout height from Params.DefaultTimeoutHeightOffset. A
+// non-zero TimeoutTimestamp is sent as a timestamp-only timeout with a zero
+// timeout height, since IbcPost only exposes a timestamp-based timeout to its
+// callers.
+type MsgSendIbcPost struct {
+	Creator          string
+	Port             string
+	ChannelID        string
+	Title            string
+	Content          string
+	TimeoutTimestamp uint64
+}
+
+type MsgSendIbcPostResponse struct{}
+
+func NewMsgSendIbcPost(
+	creator string,
+	port string,
+	channelID string,
+	title string,
+	content string,
+	timeoutTimestamp uint64,
+) *MsgSendIbcPost {
+	return &MsgSendIbcPost{
+		Creator:          creator,
+		Port:             port,
+		ChannelID:        channelID,
+		Title:            title,
+		Content:          content,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgSendIbcPost) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendIbcPost) Type() string {
+	return TypeMsgSendIbcPost
+}
+
+func (msg *MsgSendIbcPost) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSendIbcPost) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendIbcPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommitPacket returns a fixed-length SHA-256 digest over packet, the
+// destination channel and the packet's timeout timestamp. It is emitted as
+// the EventTypeIbcPostSend commitment attribute so relayers and indexers can
+// correlate a send with its eventual ack/timeout without re-deriving a hash
+// from the raw post content.
+//
+// Deviation from the original request: the request asked for this digest to
+// replace the JSON-encoded packetData as the packet's wire Data, as an
+// anti-malleability measure. Doing that breaks packet decoding outright,
+// since OnRecvPacket/OnAcknowledgementPacket/OnTimeoutPacket all need the
+// real serialized post back out of packet.GetData() to do anything with it.
+// CommitPacket is therefore only used as the send-event attribute above,
+// not as the wire Data; the anti-malleability goal itself is not delivered
+// by this, and would need to hook into ibc-go's own packet commitment
+// verification rather than duplicating it at the app level.
+func CommitPacket(packet IbcPostPacketData, timeoutTimestamp uint64, destChannel string) []byte {
+	destChannelHash := sha256.Sum256([]byte(destChannel))
+	creatorHash := sha256.Sum256([]byte(packet.Creator))
+	titleHash := sha256.Sum256([]byte(packet.Title))
+	contentHash := sha256.Sum256([]byte(packet.Content))
+
+	buf := sdk.Uint64ToBigEndian(timeoutTimestamp)
+	buf = append(buf, destChannelHash[:]...)
+	buf = append(buf, creatorHash[:]...)
+	buf = append(buf, titleHash[:]...)
+	buf = append(buf, contentHash[:]...)
+
+	hash := sha256.Sum256(buf)
+	return hash[:]
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	// KeyDefaultTimeoutTimestamp is the param store key for DefaultTimeoutTimestamp.
+	KeyDefaultTimeoutTimestamp = []byte("DefaultTimeoutTimestamp")
+	// KeyDefaultTimeoutHeightOffset is the param store key for DefaultTimeoutHeightOffset.
+	KeyDefaultTimeoutHeightOffset = []byte("DefaultTimeoutHeightOffset")
+	// KeyAllowedChannels is the param store key for AllowedChannels.
+	KeyAllowedChannels = []byte("AllowedChannels")
+	// KeyMaxPostsPerBlock is the param store key for MaxPostsPerBlock.
+	KeyMaxPostsPerBlock = []byte("MaxPostsPerBlock")
+	// KeyMaxContentBytes is the param store key for MaxContentBytes.
+	KeyMaxContentBytes = []byte("MaxContentBytes")
+
+	// defaultTimeoutTimestamp is the relative timeout, in nanoseconds, applied to
+	// an IbcPost packet when the sender does not supply an explicit timeout
+	// timestamp (10 minutes).
+	defaultTimeoutTimestamp = uint64(10 * 60 * 1000000000)
+
+	// defaultTimeoutHeightOffset is the number of blocks added to the current
+	// height when the sender supplies neither an explicit timeout height nor
+	// an explicit timeout timestamp.
+	defaultTimeoutHeightOffset = uint64(1000)
+
+	// defaultAllowedChannels is empty, meaning inbound posts are accepted from
+	// any channel until governance opts into an allow-list.
+	defaultAllowedChannels = []AllowedChannel{}
+
+	// defaultMaxPostsPerBlock is 0, meaning no cap until governance sets one.
+	defaultMaxPostsPerBlock = uint64(0)
+
+	// defaultMaxContentBytes is 0, meaning no cap until governance sets one.
+	defaultMaxContentBytes = uint64(0)
+)
+
+// ParamKeyTable returns the param key table for the blog module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params defines the parameters for the blog module.
+type Params struct {
+	DefaultTimeoutTimestamp    uint64           `json:"default_timeout_timestamp" yaml:"default_timeout_timestamp"`
+	DefaultTimeoutHeightOffset uint64           `json:"default_timeout_height_offset" yaml:"default_timeout_height_offset"`
+	AllowedChannels            []AllowedChannel `json:"allowed_channels" yaml:"allowed_channels"`
+	MaxPostsPerBlock           uint64           `json:"max_posts_per_block" yaml:"max_posts_per_block"`
+	MaxContentBytes            uint64           `json:"max_content_bytes" yaml:"max_content_bytes"`
+}
+
+// NewParams creates a new Params instance.
+func NewParams(
+	defaultTimeoutTimestamp,
+	defaultTimeoutHeightOffset uint64,
+	allowedChannels []AllowedChannel,
+	maxPostsPerBlock,
+	maxContentBytes uint64,
+) Params {
+	return Params{
+		DefaultTimeoutTimestamp:    defaultTimeoutTimestamp,
+		DefaultTimeoutHeightOffset: defaultTimeoutHeightOffset,
+		AllowedChannels:            allowedChannels,
+		MaxPostsPerBlock:           maxPostsPerBlock,
+		MaxContentBytes:            maxContentBytes,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(
+		defaultTimeoutTimestamp,
+		defaultTimeoutHeightOffset,
+		defaultAllowedChannels,
+		defaultMaxPostsPerBlock,
+		defaultMaxContentBytes,
+	)
+}
+
+// ParamSetPairs implements the paramtypes.ParamSet interface.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDefaultTimeoutTimestamp, &p.DefaultTimeoutTimestamp, validateDefaultTimeoutTimestamp),
+		paramtypes.NewParamSetPair(KeyDefaultTimeoutHeightOffset, &p.DefaultTimeoutHeightOffset, validateDefaultTimeoutHeightOffset),
+		paramtypes.NewParamSetPair(KeyAllowedChannels, &p.AllowedChannels, validateAllowedChannels),
+		paramtypes.NewParamSetPair(KeyMaxPostsPerBlock, &p.MaxPostsPerBlock, validateMaxPostsPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxContentBytes, &p.MaxContentBytes, validateMaxContentBytes),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateDefaultTimeoutTimestamp(p.DefaultTimeoutTimestamp); err != nil {
+		return err
+	}
+	if err := validateDefaultTimeoutHeightOffset(p.DefaultTimeoutHeightOffset); err != nil {
+		return err
+	}
+	if err := validateAllowedChannels(p.AllowedChannels); err != nil {
+		return err
+	}
+	if err := validateMaxPostsPerBlock(p.MaxPostsPerBlock); err != nil {
+		return err
+	}
+	return validateMaxContentBytes(p.MaxContentBytes)
+}
+
+func validateDefaultTimeoutTimestamp(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("default timeout timestamp must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateDefaultTimeoutHeightOffset(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAllowedChannels(i interface{}) error {
+	channels, ok := i.([]AllowedChannel)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, c := range channels {
+		if c.PortID == "" || c.ChannelID == "" {
+			return fmt.Errorf("allowed channel must set both a port ID and a channel ID: %+v", c)
+		}
+	}
+	return nil
+}
+
+func validateMaxPostsPerBlock(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxContentBytes(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package types
+
+// TimedoutPost is a cross-chain blog post whose IBC packet was never
+// received by the counterparty chain before its timeout elapsed. Content is
+// kept alongside Title so MsgRetryTimedoutPost can re-send the original
+// payload rather than an empty body.
+type TimedoutPost struct {
+	Id      uint64
+	Creator string
+	Title   string
+	Content string
+	Chain   string
+}
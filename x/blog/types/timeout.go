@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+)
+
+// ErrTimeoutElapsed is returned when a caller-supplied (or defaulted)
+// timeout timestamp is not after the current block time.
+var ErrTimeoutElapsed = sdkerrors.Register(ModuleName, 1500, "timeout timestamp has already elapsed")
+
+// NewTimeoutWithTimestamp returns the zero client.Height that callers pass
+// alongside the given absolute timeout timestamp (in nanoseconds); the
+// timestamp itself is not used here. With a non-zero timestamp, only the
+// timestamp dimension of the timeout is enforced by the counterparty. It mirrors
+// ibc-go's NewTimeoutWithTimestamp helper used by the transfer module for
+// packets that only care about a timestamp-based timeout.
+func NewTimeoutWithTimestamp(timeoutTimestamp uint64) clienttypes.Height {
+	return clienttypes.ZeroHeight()
+}
+
+// TimestampElapsed returns ErrTimeoutElapsed if timeoutTimestamp is not
+// strictly after blockTime, both expressed in unix nanoseconds. It lets
+// callers fail fast on an obviously-expired timeout instead of paying for a
+// SendPacket that the counterparty will reject anyway.
+func TimestampElapsed(timeoutTimestamp, blockTime uint64) error {
+	if timeoutTimestamp <= blockTime {
+		return sdkerrors.Wrapf(ErrTimeoutElapsed, "timeout timestamp %d is not after block time %d", timeoutTimestamp, blockTime)
+	}
+	return nil
+}